@@ -9,11 +9,16 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
-// ConfigurationFile contains a map for rule exclusions, and warnings, where the key is the
-// rule name to be excluded or downgraded to a warning
+// ConfigurationFile contains a map for rule exclusions, warnings, and recommendations, where
+// the key is the rule name to be excluded or downgraded to a warning or recommendation
 type ConfigurationFile struct {
-	Exclusions map[string]*ConfigurationRuleEntries `yaml:"exclusions"`
-	Warnings   map[string]*ConfigurationRuleEntries `yaml:"warnings"`
+	Exclusions      map[string]*ConfigurationRuleEntries `yaml:"exclusions"`
+	Warnings        map[string]*ConfigurationRuleEntries `yaml:"warnings"`
+	Recommendations map[string]*ConfigurationRuleEntries `yaml:"recommendations"`
+	// Scope is the currently active enforcement scope, e.g. "ci" or "nightly", set by the
+	// caller (a --scope CLI flag) rather than unmarshalled from the .lint file itself. An
+	// entry whose Scopes is non-empty only applies while its active Scope matches.
+	Scope string `yaml:"-"`
 }
 
 type ConfigurationRuleEntries struct {
@@ -31,13 +36,19 @@ type ConfigurationEntry struct {
 	Panel     string `yaml:"panel,omitempty"`
 	// This gets (un)marshalled as a string, because a 0 index is valid, but also the zero value of an int
 	TargetIdx string `yaml:"targetIdx"`
+	// Scopes restricts this entry to the listed --scope values; an empty Scopes applies in
+	// every scope. This lets a single .lint file say "excluded locally, but must fail CI".
+	Scopes []string `yaml:"scopes,omitempty"`
+	// Severity overrides the bucket's default severity (exclude/warning/recommendation) with
+	// one of "error", "warning", "recommendation", or "exclude". Empty uses the bucket default.
+	Severity string `yaml:"severity,omitempty"`
 }
 
 func (cre *ConfigurationRuleEntries) AddEntry(e ConfigurationEntry) {
 	cre.Entries = append(cre.Entries, e)
 }
 
-func (ce *ConfigurationEntry) IsMatch(r ResultContext) bool {
+func (ce *ConfigurationEntry) IsMatch(r ResultContext, scope string) bool {
 	ret := true
 	if r.Dashboard != nil && ce.Dashboard != r.Dashboard.Title {
 		ret = false
@@ -54,58 +65,72 @@ func (ce *ConfigurationEntry) IsMatch(r ResultContext) bool {
 		}
 	}
 
+	if len(ce.Scopes) > 0 && !contains(ce.Scopes, scope) {
+		ret = false
+	}
+
 	return ret
 }
 
-func (cf *ConfigurationFile) Apply(res ResultContext) ResultContext {
-	{
-		exclusions, ok := cf.Exclusions[res.Rule.Name()]
-		matched := false
-		if exclusions != nil {
-			for _, ce := range exclusions.Entries {
-				if ce.IsMatch(res) {
-					matched = true
-				}
-			}
-			if len(exclusions.Entries) == 0 {
-				matched = true
-			}
-		} else if ok {
-			matched = true
+func contains(haystack []string, needle string) bool {
+	for _, s := range haystack {
+		if s == needle {
+			return true
 		}
-		if matched {
-			res.Result.Severity = Exclude
+	}
+	return false
+}
+
+func (cf *ConfigurationFile) Apply(res ResultContext) ResultContext {
+	if sev, ok := cf.match(cf.Exclusions, res, Exclude); ok {
+		res.Result.Severity = sev
+		if sev == Exclude {
 			res.Result.Message = res.Result.Message + " (Excluded)"
 		}
 	}
 
-	{
-		warnings, ok := cf.Warnings[res.Rule.Name()]
-		matched := false
-		if warnings != nil {
-			for _, ce := range warnings.Entries {
-				if ce.IsMatch(res) {
-					matched = true
-				}
-			}
-			if len(warnings.Entries) == 0 {
-				matched = true
-			}
-		} else if ok {
-			matched = true
-		}
-		if matched {
-			res.Result.Severity = Warning
-		}
+	if sev, ok := cf.match(cf.Warnings, res, Warning); ok {
+		res.Result.Severity = sev
+	}
+
+	if sev, ok := cf.match(cf.Recommendations, res, Recommendation); ok {
+		res.Result.Severity = sev
 	}
 
 	return res
 }
 
+// match reports whether any entry in bucket applies to res under the file's active scope,
+// and if so, which Severity to use: an entry's explicit Severity override if it has one,
+// otherwise dflt (the bucket's own severity).
+func (cf *ConfigurationFile) match(bucket map[string]*ConfigurationRuleEntries, res ResultContext, dflt Severity) (Severity, bool) {
+	entries, ok := bucket[res.Rule.Name()]
+	if !ok {
+		return 0, false
+	}
+	if entries == nil || len(entries.Entries) == 0 {
+		return dflt, true
+	}
+
+	for _, ce := range entries.Entries {
+		if !ce.IsMatch(res, cf.Scope) {
+			continue
+		}
+		if ce.Severity != "" {
+			if sev, ok := parseSeverity(ce.Severity); ok {
+				return sev, true
+			}
+		}
+		return dflt, true
+	}
+	return 0, false
+}
+
 func NewConfigurationFile() *ConfigurationFile {
 	return &ConfigurationFile{
-		Exclusions: map[string]*ConfigurationRuleEntries{},
-		Warnings:   map[string]*ConfigurationRuleEntries{},
+		Exclusions:      map[string]*ConfigurationRuleEntries{},
+		Warnings:        map[string]*ConfigurationRuleEntries{},
+		Recommendations: map[string]*ConfigurationRuleEntries{},
 	}
 }
 
@@ -123,5 +148,31 @@ func (cf *ConfigurationFile) Load(path string) error {
 	if err = dec.Decode(cf); err != nil {
 		return fmt.Errorf("could not unmarshal lint configuration %s: %w", lintFilePath, err)
 	}
+
+	if err := cf.validateSeverities(); err != nil {
+		return fmt.Errorf("invalid lint configuration %s: %w", lintFilePath, err)
+	}
+	return nil
+}
+
+// validateSeverities rejects a Severity override that doesn't parse, e.g. a typo like "warn"
+// instead of "warning", rather than letting match silently fall back to the bucket's default
+// severity as if no override had been given at all.
+func (cf *ConfigurationFile) validateSeverities() error {
+	for _, bucket := range []map[string]*ConfigurationRuleEntries{cf.Exclusions, cf.Warnings, cf.Recommendations} {
+		for rule, entries := range bucket {
+			if entries == nil {
+				continue
+			}
+			for _, ce := range entries.Entries {
+				if ce.Severity == "" {
+					continue
+				}
+				if _, ok := parseSeverity(ce.Severity); !ok {
+					return fmt.Errorf("rule %q: unknown severity %q", rule, ce.Severity)
+				}
+			}
+		}
+	}
 	return nil
 }
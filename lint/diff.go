@@ -0,0 +1,49 @@
+package lint
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// writeUnifiedDiff writes a minimal unified diff between before and after, labelled with
+// name, to w. It's line-based and doesn't attempt to find a minimal edit script beyond a
+// common prefix/suffix, which is plenty for the canonicalized, indented JSON Fix produces.
+func writeUnifiedDiff(w io.Writer, name string, before, after []byte) error {
+	if bytes.Equal(before, after) {
+		return nil
+	}
+
+	beforeLines := strings.Split(string(before), "\n")
+	afterLines := strings.Split(string(after), "\n")
+
+	start := 0
+	for start < len(beforeLines) && start < len(afterLines) && beforeLines[start] == afterLines[start] {
+		start++
+	}
+
+	end := 0
+	for end < len(beforeLines)-start && end < len(afterLines)-start &&
+		beforeLines[len(beforeLines)-1-end] == afterLines[len(afterLines)-1-end] {
+		end++
+	}
+
+	if _, err := fmt.Fprintf(w, "--- a/%s\n+++ b/%s\n", name, name); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "@@ -%d,%d +%d,%d @@\n", start+1, len(beforeLines)-start-end, start+1, len(afterLines)-start-end); err != nil {
+		return err
+	}
+	for _, line := range beforeLines[start : len(beforeLines)-end] {
+		if _, err := fmt.Fprintf(w, "-%s\n", line); err != nil {
+			return err
+		}
+	}
+	for _, line := range afterLines[start : len(afterLines)-end] {
+		if _, err := fmt.Fprintf(w, "+%s\n", line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Filter restricts which rule/target combinations get linted, using the same
+// `rule-regex//target-regex` expression Gatekeeper's `gator test --run` accepts. A bare
+// `foo` (no `//`) matches if either the rule name or the target name matches `foo`.
+// `foo//` restricts the match to rule names, and `//bar` restricts it to target names
+// (dashboard or panel titles). Anchors (^/$) in either regex are honored as written. A nil
+// Filter, or one parsed from the empty string, matches everything.
+type Filter struct {
+	rule   *regexp.Regexp
+	target *regexp.Regexp
+}
+
+// NewFilter parses expr into a Filter.
+func NewFilter(expr string) (*Filter, error) {
+	if expr == "" {
+		return &Filter{}, nil
+	}
+
+	rulePart, targetPart, scoped := strings.Cut(expr, "//")
+	if !scoped {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("could not compile filter %q: %w", expr, err)
+		}
+		return &Filter{rule: re, target: re}, nil
+	}
+
+	f := &Filter{}
+	if rulePart != "" {
+		re, err := regexp.Compile(rulePart)
+		if err != nil {
+			return nil, fmt.Errorf("could not compile filter rule expression %q: %w", rulePart, err)
+		}
+		f.rule = re
+	}
+	if targetPart != "" {
+		re, err := regexp.Compile(targetPart)
+		if err != nil {
+			return nil, fmt.Errorf("could not compile filter target expression %q: %w", targetPart, err)
+		}
+		f.target = re
+	}
+	return f, nil
+}
+
+// Match reports whether ruleName/target should be linted. A bare filter (no `//` in the
+// original expression) matches if *either* side matches; a scoped filter requires each
+// non-empty side to match independently.
+func (f *Filter) Match(ruleName, target string) bool {
+	if f == nil {
+		return true
+	}
+
+	if f.rule != nil && f.rule == f.target {
+		return f.rule.MatchString(ruleName) || f.rule.MatchString(target)
+	}
+
+	if f.rule != nil && !f.rule.MatchString(ruleName) {
+		return false
+	}
+	if f.target != nil && !f.target.MatchString(target) {
+		return false
+	}
+	return true
+}
+
+// targetName returns the most specific title available on a ResultContext, for matching
+// against a Filter's target expression: a panel's title if the result is panel- or
+// target-scoped, otherwise the dashboard's title.
+func targetName(r ResultContext) string {
+	if r.Panel != nil {
+		return r.Panel.Title
+	}
+	if r.Dashboard != nil {
+		return r.Dashboard.Title
+	}
+	return ""
+}
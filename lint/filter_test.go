@@ -0,0 +1,69 @@
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilter(t *testing.T) {
+	t.Run("empty expression matches everything", func(t *testing.T) {
+		f, err := NewFilter("")
+		require.NoError(t, err)
+		require.True(t, f.Match("rule1", "dash1"))
+	})
+
+	t.Run("nil filter matches everything", func(t *testing.T) {
+		var f *Filter
+		require.True(t, f.Match("rule1", "dash1"))
+	})
+
+	t.Run("bare expression matches either side", func(t *testing.T) {
+		f, err := NewFilter("rule1")
+		require.NoError(t, err)
+		require.True(t, f.Match("rule1", "dash1"))
+		require.True(t, f.Match("other", "rule1"))
+		require.False(t, f.Match("other", "dash1"))
+	})
+
+	t.Run("rule-only expression", func(t *testing.T) {
+		f, err := NewFilter("^rule1$//")
+		require.NoError(t, err)
+		require.True(t, f.Match("rule1", "anything"))
+		require.False(t, f.Match("rule10", "anything"))
+	})
+
+	t.Run("target-only expression", func(t *testing.T) {
+		f, err := NewFilter("//^dash1$")
+		require.NoError(t, err)
+		require.True(t, f.Match("anyrule", "dash1"))
+		require.False(t, f.Match("anyrule", "dash10"))
+	})
+
+	t.Run("both sides must match when scoped", func(t *testing.T) {
+		f, err := NewFilter("rule1//dash1")
+		require.NoError(t, err)
+		require.True(t, f.Match("rule1", "dash1"))
+		require.False(t, f.Match("rule1", "dash2"))
+		require.False(t, f.Match("rule2", "dash1"))
+	})
+
+	t.Run("invalid regex is an error", func(t *testing.T) {
+		_, err := NewFilter("(unclosed")
+		require.Error(t, err)
+	})
+}
+
+func TestResultSetFilter(t *testing.T) {
+	r := ResultSet{}
+	f, err := NewFilter("^rule1$//")
+	require.NoError(t, err)
+	r.SetFilter(f)
+
+	r.AddResult(newResultContext(t, "rule1", "dash1", "", "", Error))
+	r.AddResult(newResultContext(t, "rule2", "dash1", "", "", Error))
+
+	byRule := r.ByRule()
+	require.Contains(t, byRule, "rule1")
+	require.NotContains(t, byRule, "rule2")
+}
@@ -0,0 +1,96 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Fixer is an optional interface a Rule can implement to repair the violations it reports.
+// ResultSet.Fix detects it with a type assertion against the ResultContext's Rule, the same
+// way DashboardRule/PanelRule/TargetRule are detected by a runner.
+type Fixer interface {
+	// Fix mutates dashboard (and, where relevant, panel/target, which alias into dashboard)
+	// to repair the violation it was found for, reporting whether it made a change.
+	Fix(dashboard *Dashboard, panel *Panel, target *Target) (changed bool, err error)
+}
+
+// Fix walks every result whose rule implements Fixer and applies it, skipping any result
+// that configuration has excluded or downgraded to a warning so those findings are never
+// silently rewritten. Dashboards that changed are written to w as canonicalized JSON, one
+// per dashboard; in dryRun mode a unified diff is written instead.
+func (rs *ResultSet) Fix(w io.Writer, dryRun bool) error {
+	type change struct {
+		before []byte
+		after  *Dashboard
+		order  int
+	}
+	// Keyed by *Dashboard identity, not Title: distinct dashboards routinely share a name
+	// (copied across teams/environments), and keying by title would let one collide with
+	// another's cached before/after snapshot.
+	changed := map[*Dashboard]*change{}
+
+	for _, res := range rs.results {
+		fixer, ok := res.Rule.(Fixer)
+		if !ok || res.Dashboard == nil {
+			continue
+		}
+		if res.Result.Severity == Exclude || res.Result.Severity == Warning {
+			continue
+		}
+
+		c, seen := changed[res.Dashboard]
+		if !seen {
+			before, err := json.MarshalIndent(res.Dashboard, "", "  ")
+			if err != nil {
+				return fmt.Errorf("could not marshal dashboard %q: %w", res.Dashboard.Title, err)
+			}
+			c = &change{before: before, after: res.Dashboard, order: len(changed)}
+		}
+
+		didChange, err := fixer.Fix(res.Dashboard, res.Panel, res.Target)
+		if err != nil {
+			return fmt.Errorf("could not apply fix for rule %q on dashboard %q: %w", res.Rule.Name(), res.Dashboard.Title, err)
+		}
+		if didChange {
+			changed[res.Dashboard] = c
+		}
+	}
+
+	dashboards := make([]*Dashboard, 0, len(changed))
+	for d := range changed {
+		dashboards = append(dashboards, d)
+	}
+	// Sort by Title for byte-stable output across runs instead of however Go happens to range
+	// the map that run -- the same class of fix sortedRuleNames applies to Reporter output.
+	// Dashboards that share a Title (see the comment on changed above) fall back to the order
+	// Fix first changed them in.
+	sort.SliceStable(dashboards, func(i, j int) bool {
+		ti, tj := dashboards[i].Title, dashboards[j].Title
+		if ti != tj {
+			return ti < tj
+		}
+		return changed[dashboards[i]].order < changed[dashboards[j]].order
+	})
+
+	for _, dashboard := range dashboards {
+		c := changed[dashboard]
+		after, err := json.MarshalIndent(c.after, "", "  ")
+		if err != nil {
+			return fmt.Errorf("could not marshal dashboard %q: %w", dashboard.Title, err)
+		}
+
+		if dryRun {
+			if err := writeUnifiedDiff(w, dashboard.Title, c.before, after); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if _, err := w.Write(append(after, '\n')); err != nil {
+			return err
+		}
+	}
+	return nil
+}
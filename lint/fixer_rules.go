@@ -0,0 +1,141 @@
+package lint
+
+import (
+	"fmt"
+
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+// Fix implements Fixer for TemplateJobRule: it inserts a missing `job` template, populated
+// the way NewTemplateJobRule expects it, or repoints an existing one at $datasource.
+func (r *TemplateJobRule) Fix(dashboard *Dashboard, panel *Panel, target *Target) (bool, error) {
+	if dashboard == nil {
+		return false, nil
+	}
+
+	for i, tpl := range dashboard.Templating.List {
+		if tpl.Name != "job" {
+			continue
+		}
+		changed := false
+		if tpl.Datasource != "$datasource" {
+			dashboard.Templating.List[i].Datasource = "$datasource"
+			changed = true
+		}
+		if tpl.Type != "query" {
+			dashboard.Templating.List[i].Type = "query"
+			changed = true
+		}
+		if tpl.Label != "job" {
+			dashboard.Templating.List[i].Label = "job"
+			changed = true
+		}
+		return changed, nil
+	}
+
+	dashboard.Templating.List = append(dashboard.Templating.List, Template{
+		Name:       "job",
+		Type:       "query",
+		Datasource: "$datasource",
+		Label:      "job",
+		Multi:      true,
+		AllValue:   ".+",
+	})
+	return true, nil
+}
+
+// Fix implements Fixer for PanelJobInstanceRule. LintPanel reports against the whole panel
+// rather than a single target, so Fix does the same: it walks every target on panel (falling
+// back to the single target passed in, if any, for a caller that does supply one) and
+// rewrites each PromQL query so its job/instance selectors use the =~ "$job"/"$instance"
+// matchers this rule enforces, parsing and re-serializing via the promql AST rather than
+// patching the query string directly.
+func (r *PanelJobInstanceRule) Fix(dashboard *Dashboard, panel *Panel, target *Target) (bool, error) {
+	targets := []*Target{target}
+	if panel != nil {
+		targets = targets[:0]
+		for i := range panel.Targets {
+			targets = append(targets, &panel.Targets[i])
+		}
+	}
+
+	changed := false
+	for _, t := range targets {
+		if t == nil {
+			continue
+		}
+		didChange, err := fixPromQLJobInstance(t)
+		if err != nil {
+			return false, err
+		}
+		changed = changed || didChange
+	}
+	return changed, nil
+}
+
+// fixPromQLJobInstance rewrites t.Expr in place so every vector selector has `job=~"$job"`
+// and `instance=~"$instance"` matchers, reporting whether it changed anything. An
+// unparseable expression is left untouched: that's NewPanelJobInstanceRule's job to report,
+// not Fix's to repair.
+func fixPromQLJobInstance(t *Target) (bool, error) {
+	expr, err := parser.ParseExpr(t.Expr)
+	if err != nil {
+		return false, nil
+	}
+
+	changed := false
+	var inspectErr error
+	parser.Inspect(expr, func(node parser.Node, _ []parser.Node) error {
+		vs, ok := node.(*parser.VectorSelector)
+		if !ok {
+			return nil
+		}
+		for _, name := range [...]string{"job", "instance"} {
+			didChange, err := fixJobInstanceMatcher(vs, name, "$"+name)
+			if err != nil {
+				inspectErr = fmt.Errorf("could not build %s matcher: %w", name, err)
+				return inspectErr
+			}
+			changed = changed || didChange
+		}
+		return nil
+	})
+	if inspectErr != nil {
+		return false, inspectErr
+	}
+	if !changed {
+		return false, nil
+	}
+
+	t.Expr = expr.String()
+	return true, nil
+}
+
+// fixJobInstanceMatcher ensures vs has a `name=~"value"` matcher, converting an existing `=`
+// matcher for name to `=~` or adding one if name isn't matched on at all. Matchers are built
+// via labels.NewMatcher rather than a bare struct literal so its compiled-regex cache is
+// populated, the same as every matcher the promql parser itself produces.
+func fixJobInstanceMatcher(vs *parser.VectorSelector, name, value string) (bool, error) {
+	for i, m := range vs.LabelMatchers {
+		if m.Name != name {
+			continue
+		}
+		if m.Type == labels.MatchRegexp && m.Value == value {
+			return false, nil
+		}
+		matcher, err := labels.NewMatcher(labels.MatchRegexp, name, value)
+		if err != nil {
+			return false, err
+		}
+		vs.LabelMatchers[i] = matcher
+		return true, nil
+	}
+
+	matcher, err := labels.NewMatcher(labels.MatchRegexp, name, value)
+	if err != nil {
+		return false, err
+	}
+	vs.LabelMatchers = append(vs.LabelMatchers, matcher)
+	return true, nil
+}
@@ -0,0 +1,257 @@
+package lint
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fixableTestRule is a TestRule that also implements Fixer, renaming the dashboard it's
+// pointed at so tests can assert Fix actually mutated the right one.
+type fixableTestRule struct {
+	TestRule
+}
+
+func (r *fixableTestRule) Fix(dashboard *Dashboard, panel *Panel, target *Target) (bool, error) {
+	if dashboard == nil || dashboard.Title == "fixed" {
+		return false, nil
+	}
+	dashboard.Title = "fixed"
+	return true, nil
+}
+
+func TestResultSetFix(t *testing.T) {
+	t.Run("fixes a matching result and writes the dashboard", func(t *testing.T) {
+		dashboard := &Dashboard{Title: "broken"}
+		rs := &ResultSet{results: []ResultContext{
+			{
+				Result:    Result{Severity: Error, Message: "broken"},
+				Rule:      &fixableTestRule{TestRule{name: "fixable"}},
+				Dashboard: dashboard,
+			},
+		}}
+
+		var buf bytes.Buffer
+		require.NoError(t, rs.Fix(&buf, false))
+		require.Equal(t, "fixed", dashboard.Title)
+		require.Contains(t, buf.String(), `"fixed"`)
+	})
+
+	t.Run("skips excluded and warned results", func(t *testing.T) {
+		dashboard := &Dashboard{Title: "broken"}
+		warned := &Dashboard{Title: "broken"}
+		rs := &ResultSet{results: []ResultContext{
+			{
+				Result:    Result{Severity: Exclude, Message: "broken"},
+				Rule:      &fixableTestRule{TestRule{name: "fixable"}},
+				Dashboard: dashboard,
+			},
+			{
+				Result:    Result{Severity: Warning, Message: "broken"},
+				Rule:      &fixableTestRule{TestRule{name: "fixable"}},
+				Dashboard: warned,
+			},
+		}}
+
+		var buf bytes.Buffer
+		require.NoError(t, rs.Fix(&buf, false))
+		require.Equal(t, "broken", dashboard.Title)
+		require.Equal(t, "broken", warned.Title)
+		require.Empty(t, buf.String())
+	})
+
+	t.Run("dry run writes a diff instead of the dashboard", func(t *testing.T) {
+		dashboard := &Dashboard{Title: "broken"}
+		rs := &ResultSet{results: []ResultContext{
+			{
+				Result:    Result{Severity: Error, Message: "broken"},
+				Rule:      &fixableTestRule{TestRule{name: "fixable"}},
+				Dashboard: dashboard,
+			},
+		}}
+
+		var buf bytes.Buffer
+		require.NoError(t, rs.Fix(&buf, true))
+		require.Contains(t, buf.String(), "-  \"Title\": \"broken\"")
+		require.Contains(t, buf.String(), "+  \"Title\": \"fixed\"")
+	})
+
+	t.Run("distinct dashboards sharing a title are fixed independently", func(t *testing.T) {
+		// countingFixRule distinguishes the two dashboards by their Templating length, since
+		// they otherwise start out identical (same Title) -- the scenario this test guards
+		// against is Fix keying its before/after cache by Title and mixing them up.
+		newDashboard := func(templates int) *Dashboard {
+			d := &Dashboard{Title: "shared"}
+			for i := 0; i < templates; i++ {
+				d.Templating.List = append(d.Templating.List, Template{Name: fmt.Sprintf("t%d", i)})
+			}
+			return d
+		}
+
+		dash1 := newDashboard(1)
+		dash2 := newDashboard(2)
+		rule := &countingFixRule{TestRule{name: "counting"}}
+
+		rs := &ResultSet{results: []ResultContext{
+			{Result: Result{Severity: Error, Message: "broken"}, Rule: rule, Dashboard: dash1},
+			{Result: Result{Severity: Error, Message: "broken"}, Rule: rule, Dashboard: dash2},
+		}}
+
+		var buf bytes.Buffer
+		require.NoError(t, rs.Fix(&buf, false))
+
+		require.Equal(t, "shared-fixed-1", dash1.Title)
+		require.Equal(t, "shared-fixed-2", dash2.Title)
+		require.Contains(t, buf.String(), `"shared-fixed-1"`)
+		require.Contains(t, buf.String(), `"shared-fixed-2"`)
+	})
+}
+
+// TestTemplateJobRuleFix covers every field LintDashboard checks on the job template, not just
+// Datasource, since a template that already has the right Datasource but the wrong Type or
+// Label still fails lint and Fix must not report "no change needed" for it.
+func TestTemplateJobRuleFix(t *testing.T) {
+	rule := NewTemplateJobRule()
+
+	t.Run("inserts a missing job template", func(t *testing.T) {
+		dashboard := &Dashboard{Title: "test"}
+		changed, err := rule.Fix(dashboard, nil, nil)
+		require.NoError(t, err)
+		require.True(t, changed)
+		require.Equal(t, Result{Severity: Success, Message: "OK"}, rule.LintDashboard(*dashboard))
+	})
+
+	t.Run("repoints a job template at the wrong datasource", func(t *testing.T) {
+		dashboard := &Dashboard{Title: "test", Templating: struct {
+			List []Template `json:"list"`
+		}{
+			List: []Template{{Name: "job", Datasource: "foo", Type: "query", Label: "job"}},
+		}}
+		changed, err := rule.Fix(dashboard, nil, nil)
+		require.NoError(t, err)
+		require.True(t, changed)
+		require.Equal(t, Result{Severity: Success, Message: "OK"}, rule.LintDashboard(*dashboard))
+	})
+
+	t.Run("fixes a job template with the right datasource but the wrong type", func(t *testing.T) {
+		dashboard := &Dashboard{Title: "test", Templating: struct {
+			List []Template `json:"list"`
+		}{
+			List: []Template{{Name: "job", Datasource: "$datasource", Type: "bar", Label: "job"}},
+		}}
+		changed, err := rule.Fix(dashboard, nil, nil)
+		require.NoError(t, err)
+		require.True(t, changed)
+		require.Equal(t, Result{Severity: Success, Message: "OK"}, rule.LintDashboard(*dashboard))
+	})
+
+	t.Run("fixes a job template with the right datasource but the wrong label", func(t *testing.T) {
+		dashboard := &Dashboard{Title: "test", Templating: struct {
+			List []Template `json:"list"`
+		}{
+			List: []Template{{Name: "job", Datasource: "$datasource", Type: "query", Label: "bar"}},
+		}}
+		changed, err := rule.Fix(dashboard, nil, nil)
+		require.NoError(t, err)
+		require.True(t, changed)
+		require.Equal(t, Result{Severity: Success, Message: "OK"}, rule.LintDashboard(*dashboard))
+	})
+
+	t.Run("reports no change for an already-correct job template", func(t *testing.T) {
+		dashboard := &Dashboard{Title: "test", Templating: struct {
+			List []Template `json:"list"`
+		}{
+			List: []Template{{Name: "job", Datasource: "$datasource", Type: "query", Label: "job"}},
+		}}
+		changed, err := rule.Fix(dashboard, nil, nil)
+		require.NoError(t, err)
+		require.False(t, changed)
+	})
+}
+
+// countingFixRule is a TestRule/Fixer that renames a dashboard using its Templating length,
+// so two dashboards that share a Title still end up with distinguishable results.
+type countingFixRule struct {
+	TestRule
+}
+
+func (r *countingFixRule) Fix(dashboard *Dashboard, panel *Panel, target *Target) (bool, error) {
+	if dashboard == nil {
+		return false, nil
+	}
+	dashboard.Title = fmt.Sprintf("%s-fixed-%d", dashboard.Title, len(dashboard.Templating.List))
+	return true, nil
+}
+
+// TestResultSetFixIsDeterministic guards against Fix's output loop ranging the changed map
+// directly: with several dashboards fixed in one run, the order they're written in must be
+// stable across runs instead of however Go happens to range the map that run.
+func TestResultSetFixIsDeterministic(t *testing.T) {
+	newFixture := func() *ResultSet {
+		rule := &fixableTestRule{TestRule{name: "fixable"}}
+		var results []ResultContext
+		for _, title := range []string{"zebra", "mango", "apple", "lychee"} {
+			results = append(results, ResultContext{
+				Result:    Result{Severity: Error, Message: "broken"},
+				Rule:      rule,
+				Dashboard: &Dashboard{Title: title},
+			})
+		}
+		return &ResultSet{results: results}
+	}
+
+	var outputs []string
+	for i := 0; i < 10; i++ {
+		var buf bytes.Buffer
+		require.NoError(t, newFixture().Fix(&buf, false))
+		outputs = append(outputs, buf.String())
+	}
+	for _, out := range outputs[1:] {
+		require.Equal(t, outputs[0], out, "Fix output must be stable across runs with identical results")
+	}
+}
+
+// TestPanelJobInstanceRuleFixEndToEnd runs a real multi-panel dashboard through RuleSet.Lint
+// and then ResultSet.Fix, rather than hand-assembling a ResultContext, so it actually proves
+// target.Expr mutations land back in dashboard.Panels[i].Targets[j] and not a range
+// loop-variable copy that gets discarded.
+func TestPanelJobInstanceRuleFixEndToEnd(t *testing.T) {
+	dashboards := []Dashboard{
+		{
+			Title: "dashboard",
+			Templating: struct {
+				List []Template `json:"list"`
+			}{
+				List: []Template{{Type: "datasource", Query: "prometheus"}},
+			},
+			Panels: []Panel{
+				{
+					Title: "panel1",
+					Type:  "singlestat",
+					Targets: []Target{
+						{Expr: `sum(rate(foo[5m]))`},
+					},
+				},
+				{
+					Title: "panel2",
+					Type:  "singlestat",
+					Targets: []Target{
+						{Expr: `sum(rate(bar{job="$job",instance="$instance"}[5m]))`},
+					},
+				},
+			},
+		},
+	}
+
+	rs := NewRuleSet(NewPanelJobInstanceRule())
+	result := &ResultSet{}
+	rs.Lint(dashboards, result)
+
+	require.NoError(t, result.Fix(io.Discard, false))
+
+	require.Equal(t, `sum(rate(foo{job=~"$job",instance=~"$instance"}[5m]))`, dashboards[0].Panels[0].Targets[0].Expr)
+	require.Equal(t, `sum(rate(bar{job=~"$job",instance=~"$instance"}[5m]))`, dashboards[0].Panels[1].Targets[0].Expr)
+}
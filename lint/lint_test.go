@@ -1,6 +1,8 @@
 package lint
 
 import (
+	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 
@@ -55,6 +57,24 @@ func appendConfigWarning(t *testing.T, rule string, dashboard string, panel stri
 	config.Warnings[rule] = entries
 }
 
+func appendConfigRecommendation(t *testing.T, rule string, dashboard string, panel string, targetIdx string, config *ConfigurationFile) {
+	t.Helper()
+
+	entries := config.Recommendations[rule]
+	if entries == nil {
+		entries = &ConfigurationRuleEntries{}
+	}
+
+	if dashboard != "" || panel != "" || targetIdx != "" {
+		entries.Entries = append(entries.Entries, ConfigurationEntry{
+			Dashboard: dashboard,
+			Panel:     panel,
+			TargetIdx: targetIdx,
+		})
+	}
+	config.Recommendations[rule] = entries
+}
+
 func newResultContext(t *testing.T, rule string, dashboard string, panel string, targetIdx string, result Severity) ResultContext {
 	ret := ResultContext{
 		Result: Result{Severity: result, Message: "foo"},
@@ -276,4 +296,93 @@ func TestConfiguration(t *testing.T) {
 		rc2 := c.Apply(r2)
 		require.Equal(t, Error, rc2.Result.Severity)
 	})
+
+	// Recommendations
+	t.Run("Recommends Rule", func(t *testing.T) {
+		c := NewConfigurationFile()
+		appendConfigRecommendation(t, "rule1", "", "", "", c)
+
+		r1 := newResultContext(t, "rule1", "", "", "", Error)
+		r2 := newResultContext(t, "rule2", "", "", "", Error)
+
+		rc1 := c.Apply(r1)
+		require.Equal(t, Recommendation, rc1.Result.Severity)
+
+		rc2 := c.Apply(r2)
+		require.Equal(t, Error, rc2.Result.Severity)
+	})
+
+	// Scopes
+	t.Run("Scoped entry only applies in its scope", func(t *testing.T) {
+		c := NewConfigurationFile()
+		c.Exclusions["rule1"] = &ConfigurationRuleEntries{
+			Entries: []ConfigurationEntry{{Scopes: []string{"ci"}}},
+		}
+
+		r1 := newResultContext(t, "rule1", "", "", "", Error)
+
+		c.Scope = "ci"
+		require.Equal(t, Exclude, c.Apply(r1).Result.Severity)
+
+		c.Scope = "nightly"
+		require.Equal(t, Error, c.Apply(r1).Result.Severity)
+
+		c.Scope = ""
+		require.Equal(t, Error, c.Apply(r1).Result.Severity)
+	})
+
+	t.Run("Unscoped entry applies in every scope", func(t *testing.T) {
+		c := NewConfigurationFile()
+		appendConfigExclude(t, "rule1", "", "", "", c)
+
+		r1 := newResultContext(t, "rule1", "", "", "", Error)
+
+		c.Scope = "ci"
+		require.Equal(t, Exclude, c.Apply(r1).Result.Severity)
+	})
+
+	t.Run("Entry severity override", func(t *testing.T) {
+		c := NewConfigurationFile()
+		c.Exclusions["rule1"] = &ConfigurationRuleEntries{
+			Entries: []ConfigurationEntry{{Severity: "warning"}},
+		}
+
+		r1 := newResultContext(t, "rule1", "", "", "", Error)
+		require.Equal(t, Warning, c.Apply(r1).Result.Severity)
+	})
+}
+
+func TestConfigurationFileLoad(t *testing.T) {
+	t.Run("rejects a misspelled severity override", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, ".lint"), []byte(`
+exclusions:
+  rule1:
+    entries:
+      - severity: warn
+`), 0o600))
+
+		c := NewConfigurationFile()
+		err := c.Load(dir)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `unknown severity "warn"`)
+	})
+
+	t.Run("accepts a valid severity override", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, ".lint"), []byte(`
+exclusions:
+  rule1:
+    entries:
+      - severity: warning
+`), 0o600))
+
+		c := NewConfigurationFile()
+		require.NoError(t, c.Load(dir))
+	})
+
+	t.Run("missing .lint file is not an error", func(t *testing.T) {
+		c := NewConfigurationFile()
+		require.NoError(t, c.Load(t.TempDir()))
+	})
 }
@@ -0,0 +1,42 @@
+package lint
+
+import (
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Reporter renders a ResultSet for a particular consumer — a terminal, a JSON/SARIF-reading
+// script, or a JUnit-reading CI system. ResultSet.Report delegates all formatting decisions
+// to the Reporter so adding an output format doesn't require changes to ResultSet itself.
+type Reporter interface {
+	Report(w io.Writer, rs *ResultSet) error
+}
+
+// TtyReporter reproduces ResultSet's original terminal output: each rule's description
+// followed by its results, one line per result with a symbol for its severity.
+type TtyReporter struct{}
+
+func (TtyReporter) Report(w io.Writer, rs *ResultSet) error {
+	for _, res := range rs.ByRule() {
+		if _, err := fmt.Fprintln(w, res[0].Rule.Description()); err != nil {
+			return err
+		}
+		for _, r := range res {
+			r.fprint(w)
+		}
+	}
+	return nil
+}
+
+// sortedRuleNames returns byRule's keys in sorted order, so a Reporter meant for diffable CI
+// artifacts (JSON, SARIF, JUnit) emits byte-stable output across runs with identical
+// findings instead of however Go happens to range the map that run.
+func sortedRuleNames(byRule map[string][]ResultContext) []string {
+	names := make([]string, 0, len(byRule))
+	for name := range byRule {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
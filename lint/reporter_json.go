@@ -0,0 +1,46 @@
+package lint
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// jsonResult is the JSON-serializable projection of a ResultContext used by JSONReporter.
+type jsonResult struct {
+	Rule      string    `json:"rule"`
+	Severity  string    `json:"severity"`
+	Message   string    `json:"message"`
+	Dashboard string    `json:"dashboard,omitempty"`
+	Panel     string    `json:"panel,omitempty"`
+	Location  *Location `json:"location,omitempty"`
+}
+
+// JSONReporter emits a single object keyed by rule name, each holding its list of results, for consumption by
+// scripts and CI tooling that don't want to parse TtyReporter's human-readable output.
+type JSONReporter struct{}
+
+func (JSONReporter) Report(w io.Writer, rs *ResultSet) error {
+	byRule := rs.ByRule()
+	out := map[string][]jsonResult{}
+	for _, rule := range sortedRuleNames(byRule) {
+		for _, r := range byRule[rule] {
+			jr := jsonResult{
+				Rule:     rule,
+				Severity: r.Result.Severity.String(),
+				Message:  r.Result.Message,
+				Location: r.Result.Location,
+			}
+			if r.Dashboard != nil {
+				jr.Dashboard = r.Dashboard.Title
+			}
+			if r.Panel != nil {
+				jr.Panel = r.Panel.Title
+			}
+			out[rule] = append(out[rule], jr)
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
@@ -0,0 +1,76 @@
+package lint
+
+import (
+	"encoding/xml"
+	"io"
+)
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name    string        `xml:"name,attr"`
+	Failure *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// JUnitReporter renders a ResultSet as JUnit XML: one testsuite per rule, and one testcase
+// per dashboard the rule was run against. Any CI system that already understands JUnit
+// (Jenkins, GitLab, GitHub Actions annotations, ...) can surface lint results with no
+// dashboard-linter-specific integration.
+type JUnitReporter struct{}
+
+func (JUnitReporter) Report(w io.Writer, rs *ResultSet) error {
+	var suites junitTestSuites
+
+	byRule := rs.ByRule()
+	for _, ruleName := range sortedRuleNames(byRule) {
+		suite := junitTestSuite{Name: ruleName}
+		caseIdx := map[string]int{}
+		for _, r := range byRule[ruleName] {
+			name := ruleName
+			if r.Dashboard != nil {
+				name = r.Dashboard.Title
+			}
+
+			i, seen := caseIdx[name]
+			if !seen {
+				i = len(suite.TestCases)
+				caseIdx[name] = i
+				suite.TestCases = append(suite.TestCases, junitTestCase{Name: name})
+				suite.Tests++
+			}
+			if r.Result.Severity == Error {
+				tc := &suite.TestCases[i]
+				if tc.Failure == nil {
+					suite.Failures++
+					tc.Failure = &junitFailure{Message: r.Result.Message}
+				} else {
+					tc.Failure.Text += "\n"
+				}
+				tc.Failure.Text += r.Result.Message
+			}
+		}
+		suites.Suites = append(suites.Suites, suite)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	return enc.Encode(suites)
+}
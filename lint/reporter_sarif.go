@@ -0,0 +1,148 @@
+package lint
+
+import (
+	"encoding/json"
+	"io"
+)
+
+const sarifSchema = "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json"
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string                     `json:"name"`
+	Rules []sarifReportingDescriptor `json:"rules,omitempty"`
+}
+
+type sarifReportingDescriptor struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID       string             `json:"ruleId"`
+	Level        string             `json:"level,omitempty"`
+	Message      sarifMessage       `json:"message"`
+	Locations    []sarifLocation    `json:"locations,omitempty"`
+	Suppressions []sarifSuppression `json:"suppressions,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation  `json:"physicalLocation"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine,omitempty"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
+
+type sarifSuppression struct {
+	Kind string `json:"kind"`
+}
+
+// SARIFReporter renders a ResultSet as a SARIF 2.1.0 log, the format GitHub code scanning and
+// most CI dashboards expect: one reportingDescriptor per Rule, and one result per
+// ResultContext with its Location (if any) captured as a physical + logical location.
+type SARIFReporter struct{}
+
+func (SARIFReporter) Report(w io.Writer, rs *ResultSet) error {
+	run := sarifRun{Tool: sarifTool{Driver: sarifDriver{Name: "dashboard-linter"}}}
+
+	byRule := rs.ByRule()
+	for _, ruleName := range sortedRuleNames(byRule) {
+		results := byRule[ruleName]
+		if len(results) == 0 {
+			continue
+		}
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifReportingDescriptor{
+			ID:               ruleName,
+			ShortDescription: sarifMessage{Text: results[0].Rule.Description()},
+		})
+
+		for _, r := range results {
+			sr := sarifResult{
+				RuleID:  ruleName,
+				Level:   sarifLevel(r.Result.Severity),
+				Message: sarifMessage{Text: r.Result.Message},
+			}
+			if r.Result.Severity == Exclude {
+				sr.Suppressions = []sarifSuppression{{Kind: "inSource"}}
+			}
+			if loc := sarifResultLocation(r); loc != nil {
+				sr.Locations = []sarifLocation{*loc}
+			}
+			run.Results = append(run.Results, sr)
+		}
+	}
+
+	log := sarifLog{Schema: sarifSchema, Version: "2.1.0", Runs: []sarifRun{run}}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(log)
+}
+
+// sarifLevel maps a Severity onto the SARIF result levels GitHub code scanning understands.
+func sarifLevel(s Severity) string {
+	switch s {
+	case Error:
+		return "error"
+	case Warning:
+		return "warning"
+	case Recommendation:
+		return "note"
+	default:
+		return "none"
+	}
+}
+
+func sarifResultLocation(r ResultContext) *sarifLocation {
+	loc := r.Result.Location
+	if loc == nil {
+		return nil
+	}
+
+	sl := &sarifLocation{
+		PhysicalLocation: sarifPhysicalLocation{
+			ArtifactLocation: sarifArtifactLocation{URI: loc.Path},
+		},
+	}
+	if loc.Pointer != "" {
+		sl.LogicalLocations = []sarifLogicalLocation{{FullyQualifiedName: loc.Pointer}}
+	}
+	if loc.Line > 0 {
+		sl.PhysicalLocation.Region = &sarifRegion{StartLine: loc.Line, StartColumn: loc.Column}
+	}
+	return sl
+}
@@ -0,0 +1,80 @@
+package lint
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newReporterFixture(t *testing.T) *ResultSet {
+	t.Helper()
+	rs := &ResultSet{}
+	rs.AddResult(newResultContext(t, "rule1", "dash1", "", "", Error))
+	rs.AddResult(newResultContext(t, "rule1", "dash2", "", "", Success))
+	return rs
+}
+
+func TestTtyReporter(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, newReporterFixture(t).Report(&buf, TtyReporter{}))
+	require.Contains(t, buf.String(), "Test Rule")
+	require.Contains(t, buf.String(), "foo")
+}
+
+func TestJSONReporter(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, newReporterFixture(t).Report(&buf, JSONReporter{}))
+	require.Contains(t, buf.String(), `"rule1"`)
+	require.Contains(t, buf.String(), `"severity": "error"`)
+}
+
+func TestSARIFReporter(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, newReporterFixture(t).Report(&buf, SARIFReporter{}))
+	require.Contains(t, buf.String(), `"ruleId": "rule1"`)
+	require.Contains(t, buf.String(), `"level": "error"`)
+}
+
+func TestJUnitReporter(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, newReporterFixture(t).Report(&buf, JUnitReporter{}))
+	require.Contains(t, buf.String(), `<testsuite name="rule1" tests="2" failures="1">`)
+}
+
+// newMultiRuleReporterFixture returns a ResultSet with several distinctly named rules, so a
+// test can detect a Reporter whose output order depends on Go's randomized map iteration.
+func newMultiRuleReporterFixture(t *testing.T) *ResultSet {
+	t.Helper()
+	rs := &ResultSet{}
+	for _, rule := range []string{"zebra", "mango", "apple", "lychee"} {
+		rs.AddResult(newResultContext(t, rule, "dash1", "", "", Error))
+	}
+	return rs
+}
+
+func reportN(t *testing.T, r Reporter, n int) []string {
+	t.Helper()
+	var outputs []string
+	for i := 0; i < n; i++ {
+		var buf bytes.Buffer
+		require.NoError(t, newMultiRuleReporterFixture(t).Report(&buf, r))
+		outputs = append(outputs, buf.String())
+	}
+	return outputs
+}
+
+func TestReportersAreDeterministic(t *testing.T) {
+	for name, r := range map[string]Reporter{
+		"SARIF": SARIFReporter{},
+		"JUnit": JUnitReporter{},
+		"JSON":  JSONReporter{},
+	} {
+		t.Run(name, func(t *testing.T) {
+			outputs := reportN(t, r, 10)
+			for _, out := range outputs[1:] {
+				require.Equal(t, outputs[0], out, "%s output must be stable across runs with identical findings", name)
+			}
+		})
+	}
+}
@@ -2,12 +2,42 @@ package lint
 
 import (
 	"fmt"
+	"io"
+	"os"
 	"sort"
 )
 
 type Result struct {
 	Severity Severity
 	Message  string
+	// Location optionally pinpoints where in a dashboard the result applies. Rules that
+	// already know the offending panel/target should populate it so ReportByRule can print a
+	// "at panel 'foo' in dashboards/bar.json:42:7" footer instead of just the message.
+	Location *Location
+}
+
+// Location identifies where in a dashboard file a Result applies.
+type Location struct {
+	// Path is the dashboard's source file.
+	Path string `json:"path"`
+	// Pointer is a JSON-pointer-style path into the dashboard, e.g. /panels/3/targets/1/expr.
+	Pointer string `json:"pointer,omitempty"`
+	// Line and Column are populated when the dashboard was decoded with a positional
+	// decoder; zero means unknown.
+	Line   int `json:"line,omitempty"`
+	Column int `json:"column,omitempty"`
+}
+
+// String renders a Location as "dashboards/bar.json:42:7" or, when line/column aren't known,
+// just the path.
+func (l *Location) String() string {
+	if l == nil {
+		return ""
+	}
+	if l.Line == 0 {
+		return l.Path
+	}
+	return fmt.Sprintf("%s:%d:%d", l.Path, l.Line, l.Column)
 }
 
 // ResultContext is used by ResultSet to keep all the state data about a lint execution and it's results.
@@ -20,12 +50,20 @@ type ResultContext struct {
 }
 
 func (r ResultContext) TtyPrint() {
+	r.fprint(os.Stdout)
+}
+
+// fprint is TtyPrint's underlying implementation, taking an io.Writer so TtyReporter can
+// reuse it to satisfy the Reporter interface.
+func (r ResultContext) fprint(w io.Writer) {
 	var sym string
 	switch s := r.Result.Severity; s {
 	case Success:
 		sym = "✔️"
 	case Exclude:
 		sym = "➖"
+	case Recommendation:
+		sym = "💡"
 	case Warning:
 		sym = "⚠️"
 	case Error:
@@ -34,12 +72,24 @@ func (r ResultContext) TtyPrint() {
 		return
 	}
 
-	fmt.Printf("[%s] %s\n", sym, r.Result.Message)
+	fmt.Fprintf(w, "[%s] %s\n", sym, r.Result.Message)
+	if loc := r.Result.Location; loc != nil {
+		fmt.Fprintf(w, "    at %s\n", r.locationFooter())
+	}
+}
+
+// locationFooter describes where a result applies, e.g. "panel 'foo' in dashboards/bar.json:42:7".
+func (r ResultContext) locationFooter() string {
+	if r.Panel != nil {
+		return fmt.Sprintf("panel '%s' in %s", r.Panel.Title, r.Result.Location)
+	}
+	return r.Result.Location.String()
 }
 
 type ResultSet struct {
 	results []ResultContext
 	config  *ConfigurationFile
+	filter  *Filter
 }
 
 // Configure adds, and applies the provided configuration to all results currently in the ResultSet
@@ -50,8 +100,27 @@ func (rs *ResultSet) Configure(c *ConfigurationFile) {
 	}
 }
 
-// AddResult adds a result to the ResultSet, applying the current configuration if set
+// SetFilter restricts the ResultSet to results whose rule and target match f. It should be
+// set before a rule runner starts calling AddResult so filtered-out combinations can be
+// skipped rather than merely discarded afterwards, but AddResult enforces it either way so
+// results for a filtered-out rule never show up in ByRule().
+func (rs *ResultSet) SetFilter(f *Filter) {
+	rs.filter = f
+}
+
+// ShouldRun reports whether a rule named ruleName should be run against target at all, so a
+// runner can skip calling LintDashboard/LintPanel/LintTarget entirely rather than running the
+// rule and having its result discarded.
+func (rs *ResultSet) ShouldRun(ruleName, target string) bool {
+	return rs.filter.Match(ruleName, target)
+}
+
+// AddResult adds a result to the ResultSet, applying the current configuration if set. A
+// result whose rule/target don't match the current Filter is dropped instead.
 func (rs *ResultSet) AddResult(r ResultContext) {
+	if !rs.filter.Match(r.Rule.Name(), targetName(r)) {
+		return
+	}
 	if rs.config != nil {
 	        r = rs.config.Apply(r)
 	}
@@ -82,10 +151,11 @@ func (rs *ResultSet) ByRule() map[string][]ResultContext {
 }
 
 func (rs *ResultSet) ReportByRule() {
-	for _, res := range rs.ByRule() {
-		fmt.Println(res[0].Rule.Description())
-		for _, r := range res {
-			r.TtyPrint()
-		}
-	}
+	_ = rs.Report(os.Stdout, TtyReporter{})
+}
+
+// Report renders the ResultSet with the given Reporter, e.g. TtyReporter for the original
+// terminal output, or JSONReporter/SARIFReporter/JUnitReporter for CI integration.
+func (rs *ResultSet) Report(w io.Writer, r Reporter) error {
+	return r.Report(w, rs)
 }
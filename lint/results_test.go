@@ -0,0 +1,33 @@
+package lint
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestLocationJSONTags guards Location's JSON schema: reporter_json.go nests it inside an
+// otherwise lowercase/camelCase object, so its own fields must be lowercase too.
+func TestLocationJSONTags(t *testing.T) {
+	b, err := json.Marshal(Location{Path: "dashboards/foo.json", Pointer: "/panels/0", Line: 42, Column: 7})
+	require.NoError(t, err)
+
+	var out map[string]interface{}
+	require.NoError(t, json.Unmarshal(b, &out))
+	require.Equal(t, map[string]interface{}{
+		"path":    "dashboards/foo.json",
+		"pointer": "/panels/0",
+		"line":    float64(42),
+		"column":  float64(7),
+	}, out)
+}
+
+// TestLocationJSONOmitsZeroFields ensures Pointer/Line/Column drop out of the JSON entirely
+// when unset, rather than appearing as "" / 0 alongside CamelCase siblings elsewhere in a
+// reporter's output.
+func TestLocationJSONOmitsZeroFields(t *testing.T) {
+	b, err := json.Marshal(Location{Path: "dashboards/foo.json"})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"path": "dashboards/foo.json"}`, string(b))
+}
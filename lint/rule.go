@@ -0,0 +1,28 @@
+package lint
+
+// Rule is the common interface every lint rule implements, whether built into the linter,
+// loaded from a Rego policy via LoadRegoRules, or anything else that wants to report results
+// through a ResultSet. Rule alone carries no linting behavior — see DashboardRule, PanelRule,
+// and TargetRule for the level(s) a given rule actually inspects.
+type Rule interface {
+	Name() string
+	Description() string
+}
+
+// DashboardRule is implemented by a Rule that inspects a dashboard as a whole.
+type DashboardRule interface {
+	Rule
+	LintDashboard(dashboard Dashboard) Result
+}
+
+// PanelRule is implemented by a Rule that inspects individual panels.
+type PanelRule interface {
+	Rule
+	LintPanel(dashboard Dashboard, panel Panel) Result
+}
+
+// TargetRule is implemented by a Rule that inspects individual panel targets.
+type TargetRule interface {
+	Rule
+	LintTarget(dashboard Dashboard, panel Panel, target Target) Result
+}
@@ -0,0 +1,173 @@
+package lint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/open-policy-agent/opa/rego"
+)
+
+// regoViolationQuery is the Rego package every policy is expected to populate. A policy
+// evaluates against a regoInput and should produce zero or more objects shaped like
+// regoViolation under this path.
+const regoViolationQuery = "data.dashboardlinter.violation"
+
+// regoInput is the document evaluated against each loaded policy. It mirrors whichever of
+// Dashboard, Panel, and Target are in scope for the current Lint call, marshalled through
+// their existing JSON tags so a policy sees the same shape a dashboard JSON file would have.
+type regoInput struct {
+	Dashboard *Dashboard `json:"dashboard,omitempty"`
+	Panel     *Panel     `json:"panel,omitempty"`
+	Target    *Target    `json:"target,omitempty"`
+}
+
+// regoViolation is the shape a policy's data.dashboardlinter.violation rule must produce.
+type regoViolation struct {
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+// RegoRule evaluates a single compiled Rego policy as a Rule. It implements DashboardRule,
+// PanelRule, and TargetRule so one policy file can assert on any level of the document; a
+// policy that only cares about, say, panels can simply ignore the dashboard/target fields of
+// its input.
+type RegoRule struct {
+	name  string
+	query rego.PreparedEvalQuery
+}
+
+// NewRegoRule compiles the policy at path. The rule's Name() is derived from the file name,
+// so `.lint-rules/panel-naming.rego` is reported as "panel-naming" and can be excluded or
+// warned via ConfigurationFile exactly like a built-in Go rule.
+func NewRegoRule(ctx context.Context, path string) (*RegoRule, error) {
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+
+	query, err := rego.New(
+		rego.Query(regoViolationQuery),
+		rego.Load([]string{path}, nil),
+	).PrepareForEval(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not compile rego rule %s: %w", path, err)
+	}
+
+	return &RegoRule{name: name, query: query}, nil
+}
+
+// LoadRegoRules compiles every `.rego` file directly under dir into a RegoRule. A missing
+// directory is not an error, since most dashboard trees won't have custom policies at all.
+func LoadRegoRules(ctx context.Context, dir string) ([]Rule, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not read rego rule directory %s: %w", dir, err)
+	}
+
+	var rules []Rule
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".rego" {
+			continue
+		}
+		rule, err := NewRegoRule(ctx, filepath.Join(dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func (r *RegoRule) Name() string {
+	return r.name
+}
+
+func (r *RegoRule) Description() string {
+	return fmt.Sprintf("custom rego policy %q", r.name)
+}
+
+func (r *RegoRule) LintDashboard(dashboard Dashboard) Result {
+	return r.eval(regoInput{Dashboard: &dashboard})
+}
+
+func (r *RegoRule) LintPanel(dashboard Dashboard, panel Panel) Result {
+	return r.eval(regoInput{Dashboard: &dashboard, Panel: &panel})
+}
+
+func (r *RegoRule) LintTarget(dashboard Dashboard, panel Panel, target Target) Result {
+	return r.eval(regoInput{Dashboard: &dashboard, Panel: &panel, Target: &target})
+}
+
+// eval runs the policy against input and returns its first reported violation, falling back
+// to Success when the policy produces nothing.
+func (r *RegoRule) eval(input regoInput) Result {
+	raw, err := json.Marshal(input)
+	if err != nil {
+		return Result{Severity: Error, Message: fmt.Sprintf("rego rule %q: could not marshal input: %s", r.name, err)}
+	}
+
+	var doc map[string]interface{}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return Result{Severity: Error, Message: fmt.Sprintf("rego rule %q: could not unmarshal input: %s", r.name, err)}
+	}
+
+	rs, err := r.query.Eval(context.Background(), rego.EvalInput(doc))
+	if err != nil {
+		return Result{Severity: Error, Message: fmt.Sprintf("rego rule %q failed to evaluate: %s", r.name, err)}
+	}
+
+	for _, result := range rs {
+		for _, expr := range result.Expressions {
+			violations, ok := expr.Value.([]interface{})
+			if !ok {
+				continue
+			}
+			for _, v := range violations {
+				violation, err := decodeRegoViolation(v)
+				if err != nil {
+					return Result{Severity: Error, Message: fmt.Sprintf("rego rule %q produced an invalid violation: %s", r.name, err)}
+				}
+				sev, ok := parseSeverity(violation.Severity)
+				if !ok {
+					return Result{Severity: Error, Message: fmt.Sprintf("rego rule %q: unknown severity %q", r.name, violation.Severity)}
+				}
+				return Result{Severity: sev, Message: violation.Message}
+			}
+		}
+	}
+
+	return Result{Severity: Success, Message: "OK"}
+}
+
+func decodeRegoViolation(v interface{}) (regoViolation, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return regoViolation{}, err
+	}
+	var violation regoViolation
+	if err := json.Unmarshal(raw, &violation); err != nil {
+		return regoViolation{}, err
+	}
+	return violation, nil
+}
+
+// parseSeverity maps the lowercase severity names used in configuration and policy output
+// onto Severity values.
+func parseSeverity(s string) (Severity, bool) {
+	switch strings.ToLower(s) {
+	case "error":
+		return Error, true
+	case "warning":
+		return Warning, true
+	case "recommendation":
+		return Recommendation, true
+	case "exclude":
+		return Exclude, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,57 @@
+package lint
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const regoPolicyFixture = `package dashboardlinter
+
+violation[{"severity": "error", "message": msg}] {
+	input.dashboard.Title == "bad"
+	msg := "dashboard must not be named 'bad'"
+}
+`
+
+func writeRegoFixture(t *testing.T, dir, name, contents string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+	return path
+}
+
+func TestRegoRule(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	path := writeRegoFixture(t, dir, "no-bad-title.rego", regoPolicyFixture)
+
+	rule, err := NewRegoRule(ctx, path)
+	require.NoError(t, err)
+	require.Equal(t, "no-bad-title", rule.Name())
+
+	require.Equal(t, Result{Severity: Success, Message: "OK"}, rule.LintDashboard(Dashboard{Title: "good"}))
+	require.Equal(t, Result{Severity: Error, Message: "dashboard must not be named 'bad'"}, rule.LintDashboard(Dashboard{Title: "bad"}))
+}
+
+func TestLoadRegoRules(t *testing.T) {
+	t.Run("missing directory is not an error", func(t *testing.T) {
+		rules, err := LoadRegoRules(context.Background(), filepath.Join(t.TempDir(), "does-not-exist"))
+		require.NoError(t, err)
+		require.Empty(t, rules)
+	})
+
+	t.Run("loads every .rego file", func(t *testing.T) {
+		dir := t.TempDir()
+		writeRegoFixture(t, dir, "one.rego", regoPolicyFixture)
+		writeRegoFixture(t, dir, "two.rego", regoPolicyFixture)
+		writeRegoFixture(t, dir, "README.md", "not a policy")
+
+		rules, err := LoadRegoRules(context.Background(), dir)
+		require.NoError(t, err)
+		require.Len(t, rules, 2)
+	})
+}
@@ -0,0 +1,52 @@
+package lint
+
+// RuleSet runs a group of Rules against a set of dashboards. It's the loop Filter and
+// ConfigurationFile are designed to be plugged into: ResultSet.ShouldRun is consulted before
+// every Lint call, so a rule/target combination a Filter excludes is never invoked at all,
+// not merely discarded from the results afterwards.
+type RuleSet struct {
+	rules []Rule
+}
+
+// NewRuleSet returns a RuleSet ready to lint with the given rules.
+func NewRuleSet(rules ...Rule) *RuleSet {
+	return &RuleSet{rules: rules}
+}
+
+// Add appends a rule to the set, e.g. one loaded at runtime via LoadRegoRules.
+func (rs *RuleSet) Add(rule Rule) {
+	rs.rules = append(rs.rules, rule)
+}
+
+// Lint runs every rule in rs against every dashboard, adding each result to result. dashboards
+// is addressed by index throughout so ResultContext.Dashboard/Panel/Target point into the
+// caller's slice rather than a range loop-variable copy, which matters once a Fixer starts
+// mutating them.
+func (rs *RuleSet) Lint(dashboards []Dashboard, result *ResultSet) {
+	for _, rule := range rs.rules {
+		for i := range dashboards {
+			rs.lintDashboard(rule, &dashboards[i], result)
+		}
+	}
+}
+
+func (rs *RuleSet) lintDashboard(rule Rule, dashboard *Dashboard, result *ResultSet) {
+	if dr, ok := rule.(DashboardRule); ok && result.ShouldRun(rule.Name(), dashboard.Title) {
+		result.AddResult(ResultContext{Result: dr.LintDashboard(*dashboard), Rule: rule, Dashboard: dashboard})
+	}
+
+	for i := range dashboard.Panels {
+		panel := &dashboard.Panels[i]
+
+		if pr, ok := rule.(PanelRule); ok && result.ShouldRun(rule.Name(), panel.Title) {
+			result.AddResult(ResultContext{Result: pr.LintPanel(*dashboard, *panel), Rule: rule, Dashboard: dashboard, Panel: panel})
+		}
+
+		for j := range panel.Targets {
+			target := &panel.Targets[j]
+			if tr, ok := rule.(TargetRule); ok && result.ShouldRun(rule.Name(), panel.Title) {
+				result.AddResult(ResultContext{Result: tr.LintTarget(*dashboard, *panel, *target), Rule: rule, Dashboard: dashboard, Panel: panel, Target: target})
+			}
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// spyDashboardRule records every dashboard title it's asked to lint, so tests can assert
+// whether Filter actually prevented a call rather than just the result being discarded.
+type spyDashboardRule struct {
+	TestRule
+	seen []string
+}
+
+func (r *spyDashboardRule) LintDashboard(dashboard Dashboard) Result {
+	r.seen = append(r.seen, dashboard.Title)
+	return Result{Severity: Success, Message: "OK"}
+}
+
+func TestRuleSetLintHonorsFilter(t *testing.T) {
+	rule := &spyDashboardRule{TestRule: TestRule{name: "rule1"}}
+	rs := NewRuleSet(rule)
+
+	f, err := NewFilter("//^keep$")
+	require.NoError(t, err)
+
+	result := &ResultSet{}
+	result.SetFilter(f)
+
+	rs.Lint([]Dashboard{{Title: "keep"}, {Title: "skip"}}, result)
+
+	require.Equal(t, []string{"keep"}, rule.seen, "LintDashboard must not be called for a dashboard the Filter excludes")
+	require.Len(t, result.ByRule()["rule1"], 1)
+}
+
+func TestRuleSetLintResultsPointIntoCallerDashboards(t *testing.T) {
+	dashboards := []Dashboard{
+		{Title: "dash1"},
+		{Title: "dash2"},
+	}
+
+	rule := &spyDashboardRule{TestRule: TestRule{name: "rule1"}}
+	rs := NewRuleSet(rule)
+
+	result := &ResultSet{}
+	rs.Lint(dashboards, result)
+
+	require.Len(t, result.results, 2)
+	// Each ResultContext's Dashboard must point into the caller's slice, not a range
+	// loop-variable copy, so a later Fix call's mutation is visible to the caller too.
+	require.Same(t, &dashboards[0], result.results[0].Dashboard)
+	require.Same(t, &dashboards[1], result.results[1].Dashboard)
+}
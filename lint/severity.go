@@ -0,0 +1,37 @@
+package lint
+
+// Severity indicates how serious a Result is and, in turn, how ResultSet.MaximumSeverity
+// and a CI caller should treat it. The ordered severities compare with the usual operators:
+// Success < Exclude < Recommendation < Warning < Error.
+type Severity int
+
+const (
+	Success Severity = iota
+	Exclude
+	Recommendation
+	Warning
+	Error
+)
+
+// Quiet is a sentinel outside the ordered severities above: a result configured as Quiet is
+// never printed and never affects MaximumSeverity, regardless of the rule's native severity.
+const Quiet Severity = -1
+
+func (s Severity) String() string {
+	switch s {
+	case Quiet:
+		return "quiet"
+	case Success:
+		return "success"
+	case Exclude:
+		return "exclude"
+	case Recommendation:
+		return "recommendation"
+	case Warning:
+		return "warning"
+	case Error:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
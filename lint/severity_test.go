@@ -0,0 +1,28 @@
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeverityOrdering(t *testing.T) {
+	require.Less(t, int(Success), int(Exclude))
+	require.Less(t, int(Exclude), int(Recommendation))
+	require.Less(t, int(Recommendation), int(Warning))
+	require.Less(t, int(Warning), int(Error))
+	require.Less(t, int(Quiet), int(Success))
+}
+
+func TestSeverityString(t *testing.T) {
+	for sev, want := range map[Severity]string{
+		Quiet:          "quiet",
+		Success:        "success",
+		Exclude:        "exclude",
+		Recommendation: "recommendation",
+		Warning:        "warning",
+		Error:          "error",
+	} {
+		require.Equal(t, want, sev.String())
+	}
+}